@@ -0,0 +1,66 @@
+// Package action dispatches the action a selector.Selection was tagged
+// with (open, copy, yank, print, ...) to the handler that performs it.
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrContinue signals that action did not terminate the run: the caller
+// should go back to the selector instead of returning. None of Runner's
+// built-in actions are non-terminal today; this exists so a future action
+// (e.g. a "filter" or "back" key) can opt into re-prompting.
+var ErrContinue = errors.New("action: continue selecting")
+
+// URLOpener opens a single URL, e.g. in the user's browser.
+type URLOpener interface {
+	OpenURL(ctx context.Context, url string) error
+}
+
+// ActionRunner performs action against urls.
+type ActionRunner interface {
+	Run(ctx context.Context, action string, urls []string) error
+}
+
+// Runner is the default ActionRunner: open delegates to an URLOpener, copy
+// and yank go to the platform clipboard, and print (or anything
+// unrecognized) is written to Writer.
+type Runner struct {
+	Opener URLOpener
+	Copy   func(ctx context.Context, text string) error
+	Writer io.Writer
+}
+
+// NewRunner returns a Runner wired to the real clipboard and os.Stdout.
+func NewRunner(opener URLOpener) *Runner {
+	return &Runner{
+		Opener: opener,
+		Copy:   NewCopier().Copy,
+		Writer: os.Stdout,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context, action string, urls []string) error {
+	switch action {
+	case "open":
+		for _, u := range urls {
+			if err := r.Opener.OpenURL(ctx, u); err != nil {
+				return fmt.Errorf("open %s: %v", u, err)
+			}
+		}
+		return nil
+	case "copy", "yank":
+		return r.Copy(ctx, strings.Join(urls, "\n"))
+	default:
+		// "print" and any action we don't recognize fall back to printing.
+		for _, u := range urls {
+			fmt.Fprintln(r.Writer, u)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,185 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type fakeOpener struct {
+	opened []string
+	err    error
+}
+
+func (f *fakeOpener) OpenURL(ctx context.Context, url string) error {
+	f.opened = append(f.opened, url)
+	return f.err
+}
+
+func TestRunner_Open(t *testing.T) {
+	opener := &fakeOpener{}
+	r := &Runner{Opener: opener}
+
+	if err := r.Run(context.Background(), "open", []string{"https://a.example", "https://b.example"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(opener.opened) != 2 {
+		t.Fatalf("expected 2 URLs opened, got %v", opener.opened)
+	}
+}
+
+func TestRunner_OpenPropagatesError(t *testing.T) {
+	opener := &fakeOpener{err: errors.New("boom")}
+	r := &Runner{Opener: opener}
+
+	if err := r.Run(context.Background(), "open", []string{"https://a.example"}); err == nil {
+		t.Fatal("expected error to propagate")
+	}
+}
+
+func TestRunner_CopyAndYankUseCopy(t *testing.T) {
+	for _, action := range []string{"copy", "yank"} {
+		t.Run(action, func(t *testing.T) {
+			var copied string
+			r := &Runner{Copy: func(ctx context.Context, text string) error {
+				copied = text
+				return nil
+			}}
+
+			if err := r.Run(context.Background(), action, []string{"https://a.example", "https://b.example"}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if copied != "https://a.example\nhttps://b.example" {
+				t.Errorf("unexpected clipboard text: %q", copied)
+			}
+		})
+	}
+}
+
+func TestRunner_PrintWritesToWriter(t *testing.T) {
+	var out strings.Builder
+	r := &Runner{Writer: &out}
+
+	if err := r.Run(context.Background(), "print", []string{"https://a.example"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "https://a.example\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func TestRunner_UnknownActionFallsBackToPrint(t *testing.T) {
+	var out strings.Builder
+	r := &Runner{Writer: &out}
+
+	if err := r.Run(context.Background(), "some-unknown-action", []string{"https://a.example"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "https://a.example\n" {
+		t.Errorf("unexpected output: %q", out.String())
+	}
+}
+
+func newTestCopier() (*Copier, *[]string) {
+	var ran []string
+	c := &Copier{
+		LookPath: func(file string) (string, error) {
+			return "", errors.New("not found")
+		},
+		Run: func(ctx context.Context, name string, args []string, stdin string) error {
+			ran = append(ran, append([]string{name}, args...)...)
+			return nil
+		},
+		Getenv: func(key string) string { return "" },
+		ReadFile: func(name string) ([]byte, error) {
+			return nil, errors.New("not found")
+		},
+	}
+	return c, &ran
+}
+
+func TestCopier_Darwin(t *testing.T) {
+	c, ran := newTestCopier()
+	c.GOOS = "darwin"
+
+	if err := c.Copy(context.Background(), "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*ran) != 1 || (*ran)[0] != "pbcopy" {
+		t.Errorf("expected pbcopy, got %v", *ran)
+	}
+}
+
+func TestCopier_Windows(t *testing.T) {
+	c, ran := newTestCopier()
+	c.GOOS = "windows"
+
+	if err := c.Copy(context.Background(), "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*ran) != 1 || (*ran)[0] != "clip" {
+		t.Errorf("expected clip, got %v", *ran)
+	}
+}
+
+func TestCopier_LinuxXclip(t *testing.T) {
+	c, ran := newTestCopier()
+	c.GOOS = "linux"
+	c.LookPath = func(file string) (string, error) {
+		if file == "xclip" {
+			return "/usr/bin/xclip", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	if err := c.Copy(context.Background(), "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"xclip", "-selection", "clipboard"}
+	if len(*ran) != len(want) {
+		t.Fatalf("expected %v, got %v", want, *ran)
+	}
+}
+
+func TestCopier_LinuxWlCopyFallback(t *testing.T) {
+	c, ran := newTestCopier()
+	c.GOOS = "linux"
+	c.LookPath = func(file string) (string, error) {
+		if file == "wl-copy" {
+			return "/usr/bin/wl-copy", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	if err := c.Copy(context.Background(), "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*ran) != 1 || (*ran)[0] != "wl-copy" {
+		t.Errorf("expected wl-copy, got %v", *ran)
+	}
+}
+
+func TestCopier_LinuxNoneFound(t *testing.T) {
+	c, _ := newTestCopier()
+	c.GOOS = "linux"
+
+	if err := c.Copy(context.Background(), "hello"); err == nil {
+		t.Fatal("expected error when no clipboard command is available")
+	}
+}
+
+func TestCopier_WSLUsesClipExe(t *testing.T) {
+	c, ran := newTestCopier()
+	c.GOOS = "linux"
+	c.ReadFile = func(name string) ([]byte, error) {
+		return []byte("5.10.0-microsoft-standard-WSL2"), nil
+	}
+
+	if err := c.Copy(context.Background(), "hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*ran) != 1 || (*ran)[0] != "clip.exe" {
+		t.Errorf("expected clip.exe, got %v", *ran)
+	}
+}
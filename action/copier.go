@@ -0,0 +1,84 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// clipboardCandidates are tried in order on Linux/BSD when no override is
+// available.
+var clipboardCandidates = []struct {
+	name string
+	args []string
+}{
+	{"xclip", []string{"-selection", "clipboard"}},
+	{"xsel", []string{"--clipboard", "--input"}},
+	{"wl-copy", nil},
+}
+
+// Copier resolves and runs the command used to put text on the system
+// clipboard, modeled after browser.Opener.
+type Copier struct {
+	GOOS     string
+	LookPath func(file string) (string, error)
+	Run      func(ctx context.Context, name string, args []string, stdin string) error
+	Getenv   func(key string) string
+	ReadFile func(name string) ([]byte, error)
+}
+
+// NewCopier returns a Copier wired to the real operating system.
+func NewCopier() *Copier {
+	return &Copier{
+		GOOS:     runtime.GOOS,
+		LookPath: exec.LookPath,
+		Run: func(ctx context.Context, name string, args []string, stdin string) error {
+			cmd := exec.CommandContext(ctx, name, args...)
+			cmd.Stdin = strings.NewReader(stdin)
+			return cmd.Run()
+		},
+		Getenv:   os.Getenv,
+		ReadFile: os.ReadFile,
+	}
+}
+
+// Copy puts text on the clipboard using the platform-specific command
+// resolved for c.
+func (c *Copier) Copy(ctx context.Context, text string) error {
+	name, args, err := c.resolve()
+	if err != nil {
+		return err
+	}
+	return c.Run(ctx, name, args, text)
+}
+
+func (c *Copier) resolve() (string, []string, error) {
+	switch c.GOOS {
+	case "darwin":
+		return "pbcopy", nil, nil
+	case "windows":
+		return "clip", nil, nil
+	default:
+		if c.isWSL() {
+			return "clip.exe", nil, nil
+		}
+		for _, candidate := range clipboardCandidates {
+			if _, err := c.LookPath(candidate.name); err == nil {
+				return candidate.name, candidate.args, nil
+			}
+		}
+		return "", nil, fmt.Errorf("action: no clipboard command found on %s", c.GOOS)
+	}
+}
+
+// isWSL reports whether we're running under Windows Subsystem for Linux.
+func (c *Copier) isWSL() bool {
+	release, err := c.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(release)), "microsoft")
+}
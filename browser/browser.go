@@ -0,0 +1,92 @@
+// Package browser opens URLs in the user's default browser across
+// platforms, modeled after skratchdot/open-golang.
+package browser
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+const envOpenerOverride = "TMUX_URLVIEW_OPENER"
+
+// linuxCandidates are tried in order on Linux/BSD when no override is set.
+var linuxCandidates = []string{"xdg-open", "sensible-browser", "x-www-browser", "www-browser"}
+
+// Opener resolves and runs the command used to open a URL. The zero value
+// is not usable directly; use NewOpener to get one wired to the real OS.
+type Opener struct {
+	GOOS     string
+	LookPath func(file string) (string, error)
+	Run      func(ctx context.Context, name string, args ...string) error
+	Getenv   func(key string) string
+	ReadFile func(name string) ([]byte, error)
+}
+
+// NewOpener returns an Opener wired to the real operating system.
+func NewOpener() *Opener {
+	return &Opener{
+		GOOS:     runtime.GOOS,
+		LookPath: exec.LookPath,
+		Run: func(ctx context.Context, name string, args ...string) error {
+			return exec.CommandContext(ctx, name, args...).Run()
+		},
+		Getenv:   os.Getenv,
+		ReadFile: os.ReadFile,
+	}
+}
+
+// Open opens url using the real operating system's default opener.
+func Open(ctx context.Context, url string) error {
+	return NewOpener().Open(ctx, url)
+}
+
+// Open opens url using the platform-specific command resolved for o.
+func (o *Opener) Open(ctx context.Context, url string) error {
+	name, args, err := o.resolve(url)
+	if err != nil {
+		return err
+	}
+	return o.Run(ctx, name, args...)
+}
+
+func (o *Opener) resolve(url string) (string, []string, error) {
+	if override := o.Getenv(envOpenerOverride); override != "" {
+		return override, []string{url}, nil
+	}
+
+	switch o.GOOS {
+	case "darwin":
+		return "open", []string{url}, nil
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}, nil
+	default:
+		if o.isWSL() {
+			if _, err := o.LookPath("wslview"); err == nil {
+				return "wslview", []string{url}, nil
+			}
+			return "cmd.exe", []string{"/c", "start", url}, nil
+		}
+		for _, candidate := range linuxCandidates {
+			if _, err := o.LookPath(candidate); err == nil {
+				return candidate, []string{url}, nil
+			}
+		}
+		if b := o.Getenv("BROWSER"); b != "" {
+			return b, []string{url}, nil
+		}
+		return "", nil, fmt.Errorf("browser: no URL opener found on %s", o.GOOS)
+	}
+}
+
+// isWSL reports whether we're running under Windows Subsystem for Linux.
+func (o *Opener) isWSL() bool {
+	release, err := o.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(release)), "microsoft")
+}
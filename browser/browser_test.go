@@ -0,0 +1,163 @@
+package browser
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func newTestOpener() (*Opener, *[]string) {
+	var ran []string
+	o := &Opener{
+		LookPath: func(file string) (string, error) {
+			return "", errors.New("not found")
+		},
+		Run: func(ctx context.Context, name string, args ...string) error {
+			ran = append(ran, append([]string{name}, args...)...)
+			return nil
+		},
+		Getenv: func(key string) string { return "" },
+		ReadFile: func(name string) ([]byte, error) {
+			return nil, errors.New("not found")
+		},
+	}
+	return o, &ran
+}
+
+func TestOpen_Darwin(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "darwin"
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"open", "https://example.com"})
+}
+
+func TestOpen_Windows(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "windows"
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"rundll32", "url.dll,FileProtocolHandler", "https://example.com"})
+}
+
+func TestOpen_LinuxXDGOpen(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "linux"
+	o.LookPath = func(file string) (string, error) {
+		if file == "xdg-open" {
+			return "/usr/bin/xdg-open", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"xdg-open", "https://example.com"})
+}
+
+func TestOpen_LinuxFallbackCandidate(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "linux"
+	o.LookPath = func(file string) (string, error) {
+		if file == "x-www-browser" {
+			return "/usr/bin/x-www-browser", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"x-www-browser", "https://example.com"})
+}
+
+func TestOpen_LinuxBrowserEnv(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "linux"
+	o.Getenv = func(key string) string {
+		if key == "BROWSER" {
+			return "my-browser"
+		}
+		return ""
+	}
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"my-browser", "https://example.com"})
+}
+
+func TestOpen_LinuxNoOpenerFound(t *testing.T) {
+	o, _ := newTestOpener()
+	o.GOOS = "linux"
+
+	if err := o.Open(context.Background(), "https://example.com"); err == nil {
+		t.Fatal("expected error when no opener is available")
+	}
+}
+
+func TestOpen_WSLPrefersWslview(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "linux"
+	o.ReadFile = func(name string) ([]byte, error) {
+		return []byte("5.10.0-microsoft-standard-WSL2"), nil
+	}
+	o.LookPath = func(file string) (string, error) {
+		if file == "wslview" {
+			return "/usr/bin/wslview", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"wslview", "https://example.com"})
+}
+
+func TestOpen_WSLFallsBackToCmdExe(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "linux"
+	o.ReadFile = func(name string) ([]byte, error) {
+		return []byte("5.10.0-microsoft-standard-WSL2"), nil
+	}
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"cmd.exe", "/c", "start", "https://example.com"})
+}
+
+func TestOpen_OpenerOverride(t *testing.T) {
+	o, ran := newTestOpener()
+	o.GOOS = "darwin"
+	o.Getenv = func(key string) string {
+		if key == envOpenerOverride {
+			return "custom-opener"
+		}
+		return ""
+	}
+
+	if err := o.Open(context.Background(), "https://example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertRan(t, ran, []string{"custom-opener", "https://example.com"})
+}
+
+func assertRan(t *testing.T, ran *[]string, want []string) {
+	t.Helper()
+	got := *ran
+	if len(got) != len(want) {
+		t.Fatalf("expected command %v, got %v", want, got)
+	}
+	for i, arg := range want {
+		if got[i] != arg {
+			t.Fatalf("expected command %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/dhamidi/tmux-urlview/action"
+	"github.com/dhamidi/tmux-urlview/browser"
+	"github.com/dhamidi/tmux-urlview/config"
+	"github.com/dhamidi/tmux-urlview/selector"
+)
+
+type InputProvider interface {
+	GetInput(ctx context.Context) ([]byte, error)
+}
+
+type URLOpener interface {
+	OpenURL(ctx context.Context, url string) error
+}
+
+type Environment interface {
+	GetEnv(key string) string
+	IsStdinTTY() bool
+}
+
+type CommandRunner interface {
+	RunCommand(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+type realInputProvider struct {
+	env       Environment
+	cmdRunner CommandRunner
+	// tmuxPane overrides the pane captured instead of $TMUX_PANE, set via --tmux-pane.
+	tmuxPane string
+	// stdin forces reading os.Stdin even inside a tmux pane, set via --stdin.
+	stdin bool
+}
+
+func (r *realInputProvider) GetInput(ctx context.Context) ([]byte, error) {
+	if !r.stdin {
+		pane := r.tmuxPane
+		if pane == "" {
+			pane = r.env.GetEnv("TMUX_PANE")
+		}
+		if r.env.IsStdinTTY() && pane != "" {
+			return r.cmdRunner.RunCommand(ctx, "tmux", "capture-pane", "-p", "-t", pane)
+		}
+	}
+	return io.ReadAll(os.Stdin)
+}
+
+type realURLOpener struct {
+	// command overrides the platform default opener, set via --opener.
+	command string
+	// rules routes specific URLs to specific openers, loaded from config.
+	rules []config.OpenerRule
+}
+
+func (r *realURLOpener) OpenURL(ctx context.Context, url string) error {
+	if r.command != "" {
+		return exec.CommandContext(ctx, r.command, url).Run()
+	}
+	if command, ok := config.MatchOpenerCommand(r.rules, url); ok && len(command) > 0 {
+		return exec.CommandContext(ctx, command[0], command[1:]...).Run()
+	}
+	return browser.Open(ctx, url)
+}
+
+type realEnvironment struct{}
+
+func (r *realEnvironment) GetEnv(key string) string {
+	return os.Getenv(key)
+}
+
+func (r *realEnvironment) IsStdinTTY() bool {
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return (stat.Mode() & os.ModeCharDevice) != 0
+}
+
+type realCommandRunner struct{}
+
+func (r *realCommandRunner) RunCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	return cmd.Output()
+}
+
+func run(ctx context.Context, inputProvider InputProvider, extractor *config.Extractor, urlSelector selector.URLSelector, actionRunner action.ActionRunner, opts selector.SelectOptions) error {
+	input, err := inputProvider.GetInput(ctx)
+	if err != nil {
+		return fmt.Errorf("error reading input: %v", err)
+	}
+
+	if extractor == nil {
+		extractor = defaultExtractor
+	}
+	urls := extractor.Extract(string(input))
+
+	if len(urls) == 0 {
+		return nil
+	}
+
+	// Loop until the user cancels or runs a terminal action; an action can
+	// ask to go back to the selector by returning action.ErrContinue.
+	for {
+		sel, err := urlSelector.Select(ctx, urls, opts)
+		if err != nil {
+			return fmt.Errorf("error selecting URL: %v", err)
+		}
+		if len(sel.URLs) == 0 {
+			return nil
+		}
+
+		chosenAction := sel.Action
+		if chosenAction == "" {
+			chosenAction = "print"
+		}
+		err = actionRunner.Run(ctx, chosenAction, sel.URLs)
+		if errors.Is(err, action.ErrContinue) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error running action %q: %v", chosenAction, err)
+		}
+		return nil
+	}
+}
+
+// defaultExtractor implements the pre-config https?:// behavior; it's only
+// reconstructed when a request doesn't supply its own config-driven one.
+var defaultExtractor = mustNewExtractor(config.Default())
+
+func mustNewExtractor(cfg *config.Config) *config.Extractor {
+	extractor, err := config.NewExtractor(cfg)
+	if err != nil {
+		panic(err) // the built-in default config is always valid
+	}
+	return extractor
+}
+
+func extractURLs(text string) []string {
+	return defaultExtractor.Extract(text)
+}
@@ -1,8 +1,12 @@
-package main
+package cmd
 
 import (
+	"context"
 	"errors"
 	"testing"
+
+	"github.com/dhamidi/tmux-urlview/action"
+	"github.com/dhamidi/tmux-urlview/selector"
 )
 
 // Fake implementations for testing
@@ -12,40 +16,66 @@ type fakeInputProvider struct {
 	err   error
 }
 
-func (f *fakeInputProvider) GetInput() ([]byte, error) {
+func (f *fakeInputProvider) GetInput(ctx context.Context) ([]byte, error) {
 	return f.input, f.err
 }
 
 type fakeCommandRunner struct {
-	output []byte
-	err    error
+	output      []byte
+	err         error
 	lastCommand string
 	lastArgs    []string
 }
 
-func (f *fakeCommandRunner) RunCommand(name string, args ...string) ([]byte, error) {
+func (f *fakeCommandRunner) RunCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
 	f.lastCommand = name
 	f.lastArgs = args
 	return f.output, f.err
 }
 
 type fakeURLSelector struct {
-	selectedURL string
-	err         error
+	selection selector.Selection
+	// selections, when set, is returned one entry per call instead of the
+	// fixed selection above; the last entry repeats once exhausted.
+	selections []selector.Selection
+	err        error
+	calls      int
 }
 
-func (f *fakeURLSelector) SelectURL(urls []string) (string, error) {
-	return f.selectedURL, f.err
+func (f *fakeURLSelector) Select(ctx context.Context, urls []string, opts selector.SelectOptions) (selector.Selection, error) {
+	if len(f.selections) == 0 {
+		return f.selection, f.err
+	}
+	idx := f.calls
+	if idx >= len(f.selections) {
+		idx = len(f.selections) - 1
+	}
+	f.calls++
+	return f.selections[idx], f.err
 }
 
-type fakeURLOpener struct {
-	openedURLs []string
-	err        error
+type fakeActionRunner struct {
+	action string
+	urls   []string
+	err    error
+	// errs, when set, is returned one entry per call instead of the fixed
+	// err above; the last entry repeats once exhausted.
+	errs  []error
+	calls int
 }
 
-func (f *fakeURLOpener) OpenURL(url string) error {
-	f.openedURLs = append(f.openedURLs, url)
-	return f.err
+func (f *fakeActionRunner) Run(ctx context.Context, action string, urls []string) error {
+	f.action = action
+	f.urls = urls
+	if len(f.errs) == 0 {
+		return f.err
+	}
+	idx := f.calls
+	if idx >= len(f.errs) {
+		idx = len(f.errs) - 1
+	}
+	f.calls++
+	return f.errs[idx]
 }
 
 type fakeEnvironment struct {
@@ -135,14 +165,14 @@ func TestRun_NoURLsFound(t *testing.T) {
 		err:   nil,
 	}
 	urlSelector := &fakeURLSelector{}
-	urlOpener := &fakeURLOpener{}
+	actionRunner := &fakeActionRunner{}
 
-	err := run(inputProvider, urlSelector, urlOpener)
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
-	if len(urlOpener.openedURLs) != 0 {
-		t.Errorf("expected no URLs to be opened, got %v", urlOpener.openedURLs)
+	if actionRunner.action != "" {
+		t.Errorf("expected no action to run, got %q", actionRunner.action)
 	}
 }
 
@@ -152,9 +182,9 @@ func TestRun_InputError(t *testing.T) {
 		err:   errors.New("input error"),
 	}
 	urlSelector := &fakeURLSelector{}
-	urlOpener := &fakeURLOpener{}
+	actionRunner := &fakeActionRunner{}
 
-	err := run(inputProvider, urlSelector, urlOpener)
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -169,12 +199,11 @@ func TestRun_URLSelectionError(t *testing.T) {
 		err:   nil,
 	}
 	urlSelector := &fakeURLSelector{
-		selectedURL: "",
-		err:         errors.New("selection error"),
+		err: errors.New("selection error"),
 	}
-	urlOpener := &fakeURLOpener{}
+	actionRunner := &fakeActionRunner{}
 
-	err := run(inputProvider, urlSelector, urlOpener)
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -183,24 +212,23 @@ func TestRun_URLSelectionError(t *testing.T) {
 	}
 }
 
-func TestRun_URLOpeningError(t *testing.T) {
+func TestRun_ActionError(t *testing.T) {
 	inputProvider := &fakeInputProvider{
 		input: []byte("Visit https://example.com"),
 		err:   nil,
 	}
 	urlSelector := &fakeURLSelector{
-		selectedURL: "https://example.com",
-		err:         nil,
+		selection: selector.Selection{URLs: []string{"https://example.com"}, Action: "open"},
 	}
-	urlOpener := &fakeURLOpener{
+	actionRunner := &fakeActionRunner{
 		err: errors.New("open error"),
 	}
 
-	err := run(inputProvider, urlSelector, urlOpener)
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
-	if err.Error() != "error opening URL: open error" {
+	if err.Error() != `error running action "open": open error` {
 		t.Errorf("expected specific error message, got %v", err)
 	}
 }
@@ -211,17 +239,16 @@ func TestRun_UserCancelsSelection(t *testing.T) {
 		err:   nil,
 	}
 	urlSelector := &fakeURLSelector{
-		selectedURL: "", // Empty string indicates cancellation
-		err:         nil,
+		selection: selector.Selection{}, // no URLs indicates cancellation
 	}
-	urlOpener := &fakeURLOpener{}
+	actionRunner := &fakeActionRunner{}
 
-	err := run(inputProvider, urlSelector, urlOpener)
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
-	if len(urlOpener.openedURLs) != 0 {
-		t.Errorf("expected no URLs to be opened, got %v", urlOpener.openedURLs)
+	if actionRunner.action != "" {
+		t.Errorf("expected no action to run, got %q", actionRunner.action)
 	}
 }
 
@@ -231,20 +258,87 @@ func TestRun_SuccessfulFlow(t *testing.T) {
 		err:   nil,
 	}
 	urlSelector := &fakeURLSelector{
-		selectedURL: "https://example.com",
-		err:         nil,
+		selection: selector.Selection{URLs: []string{"https://example.com"}, Action: "open"},
+	}
+	actionRunner := &fakeActionRunner{}
+
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if actionRunner.action != "open" {
+		t.Errorf("expected action open, got %q", actionRunner.action)
+	}
+	if len(actionRunner.urls) != 1 || actionRunner.urls[0] != "https://example.com" {
+		t.Errorf("expected https://example.com to be acted on, got %v", actionRunner.urls)
+	}
+}
+
+func TestRun_MultiSelectionDispatchesAllURLs(t *testing.T) {
+	inputProvider := &fakeInputProvider{
+		input: []byte("Visit https://example.com and https://test.org"),
+		err:   nil,
+	}
+	urlSelector := &fakeURLSelector{
+		selection: selector.Selection{
+			URLs:   []string{"https://example.com", "https://test.org"},
+			Action: "print",
+		},
+	}
+	actionRunner := &fakeActionRunner{}
+
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{Multi: true})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if len(actionRunner.urls) != 2 {
+		t.Errorf("expected 2 URLs to be acted on, got %v", actionRunner.urls)
+	}
+}
+
+func TestRun_UnknownActionFallsBackToPrint(t *testing.T) {
+	inputProvider := &fakeInputProvider{
+		input: []byte("Visit https://example.com"),
+		err:   nil,
+	}
+	urlSelector := &fakeURLSelector{
+		selection: selector.Selection{URLs: []string{"https://example.com"}},
+	}
+	actionRunner := &fakeActionRunner{}
+
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if actionRunner.action != "print" {
+		t.Errorf("expected fallback action print, got %q", actionRunner.action)
+	}
+}
+
+func TestRun_ActionContinueReprompts(t *testing.T) {
+	inputProvider := &fakeInputProvider{
+		input: []byte("Visit https://example.com and https://test.org"),
+		err:   nil,
+	}
+	urlSelector := &fakeURLSelector{
+		selections: []selector.Selection{
+			{URLs: []string{"https://example.com"}, Action: "filter"},
+			{URLs: []string{"https://test.org"}, Action: "open"},
+		},
+	}
+	actionRunner := &fakeActionRunner{
+		errs: []error{action.ErrContinue, nil},
 	}
-	urlOpener := &fakeURLOpener{}
 
-	err := run(inputProvider, urlSelector, urlOpener)
+	err := run(context.Background(), inputProvider, nil, urlSelector, actionRunner, selector.SelectOptions{})
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
-	if len(urlOpener.openedURLs) != 1 {
-		t.Errorf("expected 1 URL to be opened, got %d", len(urlOpener.openedURLs))
+	if urlSelector.calls != 2 {
+		t.Errorf("expected the selector to be consulted twice, got %d", urlSelector.calls)
 	}
-	if urlOpener.openedURLs[0] != "https://example.com" {
-		t.Errorf("expected https://example.com to be opened, got %s", urlOpener.openedURLs[0])
+	if actionRunner.action != "open" || len(actionRunner.urls) != 1 || actionRunner.urls[0] != "https://test.org" {
+		t.Errorf("expected the second selection to be acted on, got action %q urls %v", actionRunner.action, actionRunner.urls)
 	}
 }
 
@@ -257,15 +351,15 @@ func TestInputProvider_WithTmuxPane(t *testing.T) {
 		},
 		isStdinTTY: true,
 	}
-	
+
 	cmdRunner := &fakeCommandRunner{
 		output: []byte("tmux pane content with https://example.com"),
 		err:    nil,
 	}
-	
+
 	provider := &realInputProvider{env: env, cmdRunner: cmdRunner}
-	
-	input, err := provider.GetInput()
+
+	input, err := provider.GetInput(context.Background())
 	if err != nil {
 		t.Errorf("expected no error, got %v", err)
 	}
@@ -293,15 +387,15 @@ func TestInputProvider_WithTmuxPaneError(t *testing.T) {
 		},
 		isStdinTTY: true,
 	}
-	
+
 	cmdRunner := &fakeCommandRunner{
 		output: nil,
 		err:    errors.New("tmux command failed"),
 	}
-	
+
 	provider := &realInputProvider{env: env, cmdRunner: cmdRunner}
-	
-	_, err := provider.GetInput()
+
+	_, err := provider.GetInput(context.Background())
 	if err == nil {
 		t.Error("expected error when tmux command fails, got nil")
 	}
@@ -315,15 +409,15 @@ func TestInputProvider_NoTmuxPane(t *testing.T) {
 		envVars:    map[string]string{},
 		isStdinTTY: false,
 	}
-	
+
 	cmdRunner := &fakeCommandRunner{}
-	
+
 	provider := &realInputProvider{env: env, cmdRunner: cmdRunner}
-	
+
 	// This will try to read from os.Stdin, which will be empty in tests
 	// but we're testing that the tmux path is not taken
-	_, _ = provider.GetInput()
-	
+	_, _ = provider.GetInput(context.Background())
+
 	if cmdRunner.lastCommand != "" {
 		t.Errorf("expected no command to be run, but %s was called", cmdRunner.lastCommand)
 	}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/dhamidi/tmux-urlview/config"
+	"github.com/spf13/cobra"
+)
+
+// loadConfig loads the config file named by --config (or the default path),
+// then layers the --regex and --unique/--no-unique flag overrides on top,
+// but only where the user actually passed the flag on cmd's command line.
+func loadConfig(cmd *cobra.Command) (*config.Config, error) {
+	cfg, err := config.Load(configPathFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	if regexFlag != "" {
+		cfg.Extract.Patterns = []string{regexFlag}
+	}
+	if cmd.Flags().Changed("unique") || cmd.Flags().Changed("no-unique") {
+		unique := effectiveUnique()
+		cfg.Extract.Dedupe = &unique
+	}
+
+	return cfg, nil
+}
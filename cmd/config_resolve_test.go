@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// newLoadConfigTestCmd returns a Command with the same --unique/--no-unique
+// flags as rootCmd, so cmd.Flags().Changed reflects only this test's parse.
+func newLoadConfigTestCmd() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().BoolVar(&uniqueFlag, "unique", true, "")
+	cmd.Flags().BoolVar(&noUniqueFlag, "no-unique", false, "")
+	return cmd
+}
+
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfig_PreservesConfigDedupeWhenFlagNotPassed(t *testing.T) {
+	configPathFlag = writeConfig(t, "[extract]\ndedupe = false\n")
+	defer func() { configPathFlag = "" }()
+
+	cfg, err := loadConfig(newLoadConfigTestCmd())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Extract.Dedupe == nil || *cfg.Extract.Dedupe != false {
+		t.Errorf("expected config's dedupe=false to survive, got %v", cfg.Extract.Dedupe)
+	}
+}
+
+func TestLoadConfig_NoUniqueFlagOverridesConfigDedupe(t *testing.T) {
+	configPathFlag = writeConfig(t, "[extract]\ndedupe = true\n")
+	defer func() { configPathFlag = "" }()
+
+	cmd := newLoadConfigTestCmd()
+	if err := cmd.Flags().Set("no-unique", "true"); err != nil {
+		t.Fatalf("set --no-unique: %v", err)
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Extract.Dedupe == nil || *cfg.Extract.Dedupe != false {
+		t.Errorf("expected --no-unique to override config's dedupe=true, got %v", cfg.Extract.Dedupe)
+	}
+}
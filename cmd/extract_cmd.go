@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dhamidi/tmux-urlview/config"
+	"github.com/spf13/cobra"
+)
+
+var extractCmd = &cobra.Command{
+	Use:   "extract",
+	Short: "Print extracted URLs to stdout without prompting for a selection",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			return err
+		}
+		extractor, err := config.NewExtractor(cfg)
+		if err != nil {
+			return err
+		}
+
+		env := &realEnvironment{}
+		cmdRunner := &realCommandRunner{}
+		inputProvider := &realInputProvider{
+			env:       env,
+			cmdRunner: cmdRunner,
+			tmuxPane:  tmuxPaneFlag,
+			stdin:     stdinFlag,
+		}
+
+		input, err := inputProvider.GetInput(ctx)
+		if err != nil {
+			return fmt.Errorf("error reading input: %v", err)
+		}
+
+		for _, u := range extractor.Extract(string(input)) {
+			fmt.Println(u)
+		}
+		return nil
+	},
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Print a tmux key binding that invokes tmux-urlview",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Println("bind U display-popup -E 'tmux capture-pane -p | tmux-urlview'")
+		return nil
+	},
+}
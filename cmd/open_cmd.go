@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var openCmd = &cobra.Command{
+	Use:   "open <url>",
+	Short: "Open a single URL using the configured opener",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfig(cmd)
+		if err != nil {
+			return err
+		}
+
+		urlOpener := &realURLOpener{command: openerFlag, rules: cfg.Openers}
+		if err := urlOpener.OpenURL(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("error opening URL: %v", err)
+		}
+		return nil
+	},
+}
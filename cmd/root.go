@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	selectorFlag   string
+	openerFlag     string
+	tmuxPaneFlag   string
+	multiFlag      bool
+	regexFlag      string
+	uniqueFlag     bool
+	noUniqueFlag   bool
+	stdinFlag      bool
+	configPathFlag string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "tmux-urlview",
+	Short: "Pick a URL out of a tmux pane and open it",
+	RunE:  runRunE,
+}
+
+func init() {
+	flags := rootCmd.PersistentFlags()
+	flags.StringVar(&selectorFlag, "selector", "", "URL selector backend (fzf, skim, rofi, dmenu, fzy)")
+	flags.StringVar(&openerFlag, "opener", "", "command used to open the selected URL")
+	flags.StringVar(&tmuxPaneFlag, "tmux-pane", "", "tmux pane to capture instead of $TMUX_PANE")
+	flags.BoolVar(&multiFlag, "multi", false, "allow selecting multiple URLs")
+	flags.StringVar(&regexFlag, "regex", "", "override the URL matching regular expression")
+	flags.BoolVar(&uniqueFlag, "unique", true, "deduplicate extracted URLs")
+	flags.BoolVar(&noUniqueFlag, "no-unique", false, "alias for --unique=false")
+	flags.BoolVar(&stdinFlag, "stdin", false, "read input from stdin instead of the tmux pane")
+	flags.StringVar(&configPathFlag, "config", "", "path to config file")
+
+	rootCmd.AddCommand(initCmd, runCmd, extractCmd, openCmd)
+}
+
+// Execute runs the root command, cancelling it on SIGINT/SIGTERM so that
+// subprocesses like fzf or tmux are torn down cleanly.
+func Execute() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// effectiveUnique resolves --unique/--no-unique to a single boolean.
+func effectiveUnique() bool {
+	if noUniqueFlag {
+		return false
+	}
+	return uniqueFlag
+}
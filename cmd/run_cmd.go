@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/dhamidi/tmux-urlview/action"
+	"github.com/dhamidi/tmux-urlview/config"
+	"github.com/dhamidi/tmux-urlview/selector"
+	"github.com/spf13/cobra"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Extract URLs from a tmux pane and act on the one the user picks (default)",
+	RunE:  runRunE,
+}
+
+func runRunE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+	extractor, err := config.NewExtractor(cfg)
+	if err != nil {
+		return err
+	}
+
+	env := &realEnvironment{}
+	cmdRunner := &realCommandRunner{}
+	inputProvider := &realInputProvider{
+		env:       env,
+		cmdRunner: cmdRunner,
+		tmuxPane:  tmuxPaneFlag,
+		stdin:     stdinFlag,
+	}
+	urlSelector, err := resolveSelector()
+	if err != nil {
+		return err
+	}
+	urlOpener := &realURLOpener{command: openerFlag, rules: cfg.Openers}
+	actionRunner := action.NewRunner(urlOpener)
+
+	return run(ctx, inputProvider, extractor, urlSelector, actionRunner, selector.SelectOptions{Multi: multiFlag})
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/dhamidi/tmux-urlview/selector"
+)
+
+// resolveSelector looks up the backend named by --selector, or auto-detects
+// one from $PATH when the flag is unset.
+func resolveSelector() (selector.URLSelector, error) {
+	name := selectorFlag
+	if name == "" {
+		name = selector.Auto(exec.LookPath)
+	}
+
+	factory, ok := selector.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown selector %q", name)
+	}
+	return factory(), nil
+}
@@ -0,0 +1,97 @@
+// Package config loads tmux-urlview's TOML configuration, letting users
+// extend URL extraction and route specific URLs to specific openers.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+// DefaultPattern and DefaultTrimTrailing match extractURLs' pre-config
+// behavior, so an absent or empty config changes nothing.
+const (
+	DefaultPattern      = `https?://[^\s]+`
+	DefaultTrimTrailing = ".,;!?)(]}"
+)
+
+// Config is the [extract] / [[opener]] schema loaded from config.toml.
+type Config struct {
+	Extract ExtractConfig `toml:"extract"`
+	Openers []OpenerRule  `toml:"opener"`
+}
+
+// ExtractConfig controls how URLs are pulled out of captured text.
+type ExtractConfig struct {
+	Patterns     []string `toml:"patterns"`
+	TrimTrailing string   `toml:"trim_trailing"`
+	// Dedupe is a pointer so an absent key defaults to true rather than to
+	// the bool zero value.
+	Dedupe *bool `toml:"dedupe"`
+}
+
+func (e ExtractConfig) dedupe() bool {
+	if e.Dedupe == nil {
+		return true
+	}
+	return *e.Dedupe
+}
+
+// OpenerRule routes URLs matching Scheme or Pattern to Command instead of
+// the platform default opener. "{}" in Command is replaced with the URL.
+type OpenerRule struct {
+	Scheme  string   `toml:"scheme"`
+	Pattern string   `toml:"pattern"`
+	Command []string `toml:"command"`
+}
+
+// Default returns the configuration used when no config file is found.
+func Default() *Config {
+	return &Config{
+		Extract: ExtractConfig{
+			Patterns:     []string{DefaultPattern},
+			TrimTrailing: DefaultTrimTrailing,
+		},
+	}
+}
+
+// DefaultPath returns $XDG_CONFIG_HOME/tmux-urlview/config.toml, falling
+// back to ~/.config/tmux-urlview/config.toml.
+func DefaultPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "tmux-urlview", "config.toml")
+}
+
+// Load reads the config file at path, or at DefaultPath if path is empty.
+// A missing file is not an error: Load returns Default() instead.
+func Load(path string) (*Config, error) {
+	if path == "" {
+		path = DefaultPath()
+	}
+	if path == "" {
+		return Default(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Default(), nil
+		}
+		return nil, fmt.Errorf("config: read %s: %v", path, err)
+	}
+
+	cfg := Default()
+	if _, err := toml.Decode(string(data), cfg); err != nil {
+		return nil, fmt.Errorf("config: parse %s: %v", path, err)
+	}
+	return cfg, nil
+}
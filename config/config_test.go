@@ -0,0 +1,153 @@
+package config
+
+import "testing"
+
+func TestLoad_MissingFileFallsBackToDefault(t *testing.T) {
+	cfg, err := Load("/nonexistent/path/config.toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Extract.Patterns) != 1 || cfg.Extract.Patterns[0] != DefaultPattern {
+		t.Errorf("expected default pattern, got %v", cfg.Extract.Patterns)
+	}
+}
+
+func TestLoad_Fixture(t *testing.T) {
+	cfg, err := Load("testdata/config.toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(cfg.Extract.Patterns) != 2 {
+		t.Fatalf("expected 2 patterns, got %v", cfg.Extract.Patterns)
+	}
+	if !cfg.Extract.dedupe() {
+		t.Error("expected dedupe to be true")
+	}
+	if len(cfg.Openers) != 2 {
+		t.Fatalf("expected 2 opener rules, got %d", len(cfg.Openers))
+	}
+}
+
+func TestNewExtractor_FixtureExtractsCustomSchemes(t *testing.T) {
+	cfg, err := Load("testdata/config.toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	extractor, err := NewExtractor(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := extractor.Extract("See https://example.com and git@github.com:dhamidi/tmux-urlview.git")
+	want := []string{"https://example.com", "git@github.com:dhamidi/tmux-urlview.git"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestNewExtractor_DedupeFalseKeepsDuplicates(t *testing.T) {
+	off := false
+	cfg := &Config{Extract: ExtractConfig{Patterns: []string{DefaultPattern}, Dedupe: &off}}
+
+	extractor, err := NewExtractor(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := extractor.Extract("https://example.com https://example.com")
+	if len(got) != 2 {
+		t.Errorf("expected 2 matches without dedupe, got %v", got)
+	}
+}
+
+func TestNewExtractor_PreservesTextOrderAcrossPatterns(t *testing.T) {
+	cfg := &Config{Extract: ExtractConfig{Patterns: []string{`https?://[^\s]+`, `ftp://[^\s]+`}}}
+
+	extractor, err := NewExtractor(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := extractor.Extract("first ftp://a.com then https://b.com")
+	want := []string{"ftp://a.com", "https://b.com"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestNewExtractor_DropsMalformedURLMatches(t *testing.T) {
+	cfg := &Config{Extract: ExtractConfig{Patterns: []string{DefaultPattern}}}
+
+	extractor, err := NewExtractor(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := extractor.Extract("Valid: https://example.com Malformed: https://example.com%")
+	want := []string{"https://example.com"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestNewExtractor_InvalidPatternErrors(t *testing.T) {
+	cfg := &Config{Extract: ExtractConfig{Patterns: []string{"("}}}
+	if _, err := NewExtractor(cfg); err == nil {
+		t.Fatal("expected error for invalid regex")
+	}
+}
+
+func TestMatchOpenerCommand_BySchemeFixture(t *testing.T) {
+	cfg, err := Load("testdata/config.toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	command, ok := MatchOpenerCommand(cfg.Openers, "mailto:dev@example.com")
+	if !ok {
+		t.Fatal("expected a match for mailto scheme")
+	}
+	want := []string{"thunderbird", "mailto:dev@example.com"}
+	if len(command) != len(want) || command[0] != want[0] || command[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, command)
+	}
+}
+
+func TestMatchOpenerCommand_ByPatternFixture(t *testing.T) {
+	cfg, err := Load("testdata/config.toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	command, ok := MatchOpenerCommand(cfg.Openers, "git@github.com:dhamidi/tmux-urlview.git")
+	if !ok {
+		t.Fatal("expected a match for git@ pattern")
+	}
+	want := []string{"git-clone-helper", "git@github.com:dhamidi/tmux-urlview.git"}
+	if len(command) != len(want) || command[0] != want[0] || command[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, command)
+	}
+}
+
+func TestMatchOpenerCommand_NoMatch(t *testing.T) {
+	cfg, err := Load("testdata/config.toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := MatchOpenerCommand(cfg.Openers, "https://example.com"); ok {
+		t.Error("expected no match for an unrelated URL")
+	}
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Extractor is a Config's [extract] section compiled into ready-to-run
+// regular expressions.
+type Extractor struct {
+	patterns     []*regexp.Regexp
+	trimTrailing string
+	dedupe       bool
+}
+
+// NewExtractor compiles cfg's patterns. A nil cfg compiles Default().
+func NewExtractor(cfg *Config) (*Extractor, error) {
+	if cfg == nil {
+		cfg = Default()
+	}
+
+	patterns := cfg.Extract.Patterns
+	if len(patterns) == 0 {
+		patterns = Default().Extract.Patterns
+	}
+
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("config: invalid pattern %q: %v", p, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return &Extractor{
+		patterns:     compiled,
+		trimTrailing: cfg.Extract.TrimTrailing,
+		dedupe:       cfg.Extract.dedupe(),
+	}, nil
+}
+
+// Extract returns every match of e's patterns in text, in order of
+// appearance, trimmed of trailing punctuation and deduplicated per
+// e.dedupe.
+func (e *Extractor) Extract(text string) []string {
+	type match struct {
+		start int
+		text  string
+	}
+
+	var matches []match
+	for _, re := range e.patterns {
+		for _, loc := range re.FindAllStringIndex(text, -1) {
+			matches = append(matches, match{start: loc[0], text: text[loc[0]:loc[1]]})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].start < matches[j].start })
+
+	var urls []string
+	seen := make(map[string]bool)
+	for _, m := range matches {
+		cleaned := strings.TrimRight(m.text, e.trimTrailing)
+		if !validURL(cleaned) {
+			continue
+		}
+		if e.dedupe {
+			if seen[cleaned] {
+				continue
+			}
+			seen[cleaned] = true
+		}
+		urls = append(urls, cleaned)
+	}
+
+	return urls
+}
+
+// validURL rejects malformed scheme://... matches (e.g. a trailing "%" or a
+// bad percent-escape), the same check extractURLs used to apply before
+// extraction moved into Extractor. It's skipped for matches without "://",
+// since those are user@host:path or scheme:opaque forms (git@, mailto:)
+// that url.Parse isn't meant to validate.
+func validURL(s string) bool {
+	if !strings.Contains(s, "://") {
+		return true
+	}
+	_, err := url.Parse(s)
+	return err == nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MatchOpenerCommand returns the command for the first OpenerRule matching
+// rawURL by scheme or pattern, with "{}" replaced by rawURL. ok is false if
+// no rule matches, meaning the caller should fall back to the platform
+// default opener.
+func MatchOpenerCommand(rules []OpenerRule, rawURL string) (command []string, ok bool) {
+	scheme := ""
+	if u, err := url.Parse(rawURL); err == nil {
+		scheme = u.Scheme
+	}
+
+	for _, rule := range rules {
+		switch {
+		case rule.Scheme != "" && rule.Scheme == scheme:
+			return substitute(rule.Command, rawURL), true
+		case rule.Pattern != "":
+			if matched, err := regexp.MatchString(rule.Pattern, rawURL); err == nil && matched {
+				return substitute(rule.Command, rawURL), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func substitute(command []string, rawURL string) []string {
+	out := make([]string, len(command))
+	for i, arg := range command {
+		out[i] = strings.ReplaceAll(arg, "{}", rawURL)
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package selector
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const builtinName = "builtin"
+
+// builtinSelector is the pure-Go fallback used when no external selector
+// binary is available on $PATH. It prints a numbered list and reads the
+// chosen index from stdin, so it also works when stdout is not a TTY.
+type builtinSelector struct {
+	out io.Writer
+	in  io.Reader
+}
+
+func (b *builtinSelector) Select(ctx context.Context, urls []string, opts SelectOptions) (Selection, error) {
+	out := b.out
+	if out == nil {
+		out = os.Stderr
+	}
+	in := b.in
+	if in == nil {
+		in = os.Stdin
+	}
+
+	for i, u := range urls {
+		fmt.Fprintf(out, "%d) %s\n", i+1, u)
+	}
+	fmt.Fprint(out, "select URL (empty to cancel): ")
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return Selection{}, err
+	}
+	line = strings.TrimSpace(line)
+	if line == "" || line == "q" {
+		return Selection{}, nil
+	}
+
+	index, err := strconv.Atoi(line)
+	if err != nil || index < 1 || index > len(urls) {
+		return Selection{}, fmt.Errorf("invalid selection %q", line)
+	}
+
+	return Selection{URLs: []string{urls[index-1]}, Action: "open"}, nil
+}
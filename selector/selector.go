@@ -0,0 +1,215 @@
+// Package selector provides pluggable backends for picking one or more
+// URLs out of a list, plus a registry so callers can look one up by name.
+package selector
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// SelectOptions configures a single Select call.
+type SelectOptions struct {
+	// Multi allows selecting more than one URL, where the backend supports it.
+	Multi bool
+	// ExpectKeys are the keys a backend should report as the pressed action
+	// key, for backends that support fzf-style --expect. Defaults apply when
+	// empty.
+	ExpectKeys []string
+}
+
+// Selection is what the user picked: one or more URLs, plus the action key
+// they pressed to make the pick (e.g. "open", "copy", "yank", "print").
+// An empty URLs slice means the user cancelled.
+type Selection struct {
+	URLs   []string
+	Action string
+}
+
+// URLSelector picks zero or more URLs out of urls, dispatched by Action.
+type URLSelector interface {
+	Select(ctx context.Context, urls []string, opts SelectOptions) (Selection, error)
+}
+
+// Factory builds a URLSelector for a registered backend.
+type Factory func() URLSelector
+
+var registry = map[string]Factory{}
+
+// priority is the order backends are tried in when auto-selecting.
+var priority []string
+
+// Register adds a named backend to the registry.
+func Register(name string, factory Factory) {
+	if _, exists := registry[name]; !exists {
+		priority = append(priority, name)
+	}
+	registry[name] = factory
+}
+
+// Get looks up a backend by name.
+func Get(name string) (Factory, bool) {
+	factory, ok := registry[name]
+	return factory, ok
+}
+
+// Auto returns the name of the first backend available on $PATH, trying
+// backends in registration order and falling back to "builtin" if none of
+// them are found.
+func Auto(lookPath func(file string) (string, error)) string {
+	for _, name := range priority {
+		if name == builtinName {
+			continue
+		}
+		if _, err := lookPath(name); err == nil {
+			return name
+		}
+	}
+	return builtinName
+}
+
+// CommandRunner runs a selector backend's command, feeding it stdin and
+// reporting its exit code so cancellation can be distinguished from failure.
+type CommandRunner interface {
+	Run(ctx context.Context, name string, args []string, stdin string) (stdout []byte, exitCode int, err error)
+}
+
+type realCommandRunner struct{}
+
+func (realCommandRunner) Run(ctx context.Context, name string, args []string, stdin string) ([]byte, int, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	output, err := cmd.Output()
+	exitCode := 0
+	if cmd.ProcessState != nil {
+		exitCode = cmd.ProcessState.ExitCode()
+	}
+	return output, exitCode, err
+}
+
+// execSelector runs a single-select external command, treating the exit
+// codes in cancelExitCodes as "the user cancelled" rather than an error.
+// Its only action is "open".
+type execSelector struct {
+	command         string
+	baseArgs        []string
+	cancelExitCodes map[int]bool
+	runner          CommandRunner
+}
+
+func (s *execSelector) Select(ctx context.Context, urls []string, opts SelectOptions) (Selection, error) {
+	output, exitCode, err := s.runner.Run(ctx, s.command, s.baseArgs, strings.Join(urls, "\n"))
+	if err != nil {
+		if s.cancelExitCodes[exitCode] {
+			return Selection{}, nil
+		}
+		return Selection{}, fmt.Errorf("%s: %v", s.command, err)
+	}
+	selected := strings.TrimSpace(string(output))
+	if selected == "" {
+		return Selection{}, nil
+	}
+	return Selection{URLs: []string{selected}, Action: "open"}, nil
+}
+
+// defaultExpectKeys are the fzf/skim --expect keys used when SelectOptions
+// doesn't specify its own, mapped to the action each one triggers.
+var defaultKeyActions = map[string]string{
+	"ctrl-y": "yank",
+	"ctrl-o": "open",
+	"enter":  "open",
+}
+
+func defaultExpectKeys() []string {
+	return []string{"ctrl-y", "ctrl-o", "enter"}
+}
+
+// expectSelector runs an fzf-compatible command with --print-query and
+// --expect, parsing its output convention: first line is the query, second
+// is the pressed key, remaining lines are the selected items.
+type expectSelector struct {
+	command         string
+	cancelExitCodes map[int]bool
+	runner          CommandRunner
+	keyActions      map[string]string
+}
+
+func (s *expectSelector) Select(ctx context.Context, urls []string, opts SelectOptions) (Selection, error) {
+	args := []string{"--print-query"}
+	if opts.Multi {
+		args = append(args, "--multi")
+	}
+	keys := opts.ExpectKeys
+	if len(keys) == 0 {
+		keys = defaultExpectKeys()
+	}
+	args = append(args, "--expect="+strings.Join(keys, ","))
+
+	output, exitCode, err := s.runner.Run(ctx, s.command, args, strings.Join(urls, "\n"))
+	if err != nil {
+		if s.cancelExitCodes[exitCode] {
+			return Selection{}, nil
+		}
+		return Selection{}, fmt.Errorf("%s: %v", s.command, err)
+	}
+
+	lines := strings.Split(string(output), "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	// First line is the query, second is the pressed key; an empty second
+	// line or nothing selected means the user cancelled.
+	if len(lines) < 2 {
+		return Selection{}, nil
+	}
+	key := lines[1]
+	chosen := lines[2:]
+	if len(chosen) == 0 {
+		return Selection{}, nil
+	}
+
+	action := s.keyActions[key]
+	if action == "" {
+		action = "open"
+	}
+	return Selection{URLs: chosen, Action: action}, nil
+}
+
+func newExpectFactory(command string) Factory {
+	return func() URLSelector {
+		return &expectSelector{
+			command:         command,
+			cancelExitCodes: map[int]bool{1: true, 130: true},
+			runner:          realCommandRunner{},
+			keyActions:      defaultKeyActions,
+		}
+	}
+}
+
+func newExecFactory(command string, baseArgs []string, cancelExitCodes ...int) Factory {
+	cancel := make(map[int]bool, len(cancelExitCodes))
+	for _, code := range cancelExitCodes {
+		cancel[code] = true
+	}
+	return func() URLSelector {
+		return &execSelector{
+			command:         command,
+			baseArgs:        baseArgs,
+			cancelExitCodes: cancel,
+			runner:          realCommandRunner{},
+		}
+	}
+}
+
+func init() {
+	Register("fzf", newExpectFactory("fzf"))
+	Register("sk", newExpectFactory("sk"))
+	Register("rofi", newExecFactory("rofi", []string{"-dmenu"}, 1))
+	Register("dmenu", newExecFactory("dmenu", nil, 1))
+	Register("fzy", newExecFactory("fzy", nil, 1, 130))
+
+	Register(builtinName, func() URLSelector {
+		return &builtinSelector{}
+	})
+}
@@ -0,0 +1,291 @@
+package selector
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type fakeCommandRunner struct {
+	lastName  string
+	lastArgs  []string
+	lastStdin string
+	output    []byte
+	exitCode  int
+	err       error
+}
+
+func (f *fakeCommandRunner) Run(ctx context.Context, name string, args []string, stdin string) ([]byte, int, error) {
+	f.lastName = name
+	f.lastArgs = args
+	f.lastStdin = stdin
+	return f.output, f.exitCode, f.err
+}
+
+func TestExecSelector_ArgvConstruction(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		wantCmd  string
+		wantArgs []string
+	}{
+		{name: "rofi", backend: "rofi", wantCmd: "rofi", wantArgs: []string{"-dmenu"}},
+		{name: "dmenu", backend: "dmenu", wantCmd: "dmenu", wantArgs: nil},
+		{name: "fzy", backend: "fzy", wantCmd: "fzy", wantArgs: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, ok := Get(tt.backend)
+			if !ok {
+				t.Fatalf("backend %q not registered", tt.backend)
+			}
+			sel := factory()
+			es, ok := sel.(*execSelector)
+			if !ok {
+				t.Fatalf("backend %q did not produce an execSelector", tt.backend)
+			}
+			runner := &fakeCommandRunner{output: []byte("https://example.com\n"), exitCode: 0}
+			es.runner = runner
+
+			got, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got.URLs) != 1 || got.URLs[0] != "https://example.com" {
+				t.Errorf("expected https://example.com, got %v", got.URLs)
+			}
+			if got.Action != "open" {
+				t.Errorf("expected action open, got %q", got.Action)
+			}
+			if runner.lastName != tt.wantCmd {
+				t.Errorf("expected command %q, got %q", tt.wantCmd, runner.lastName)
+			}
+			if len(runner.lastArgs) != len(tt.wantArgs) {
+				t.Fatalf("expected args %v, got %v", tt.wantArgs, runner.lastArgs)
+			}
+			for i := range tt.wantArgs {
+				if runner.lastArgs[i] != tt.wantArgs[i] {
+					t.Errorf("expected args %v, got %v", tt.wantArgs, runner.lastArgs)
+				}
+			}
+		})
+	}
+}
+
+func TestExecSelector_Cancellation(t *testing.T) {
+	tests := []struct {
+		backend  string
+		exitCode int
+	}{
+		{"rofi", 1},
+		{"dmenu", 1},
+		{"fzy", 1},
+		{"fzy", 130},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.backend, func(t *testing.T) {
+			factory, _ := Get(tt.backend)
+			es := factory().(*execSelector)
+			es.runner = &fakeCommandRunner{exitCode: tt.exitCode, err: errors.New("exit status")}
+
+			got, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+			if err != nil {
+				t.Fatalf("expected cancellation to not be an error, got %v", err)
+			}
+			if len(got.URLs) != 0 {
+				t.Errorf("expected no selection, got %v", got.URLs)
+			}
+		})
+	}
+}
+
+func TestExecSelector_UnexpectedExitIsError(t *testing.T) {
+	factory, _ := Get("rofi")
+	es := factory().(*execSelector)
+	es.runner = &fakeCommandRunner{exitCode: 2, err: errors.New("boom")}
+
+	_, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+	if err == nil {
+		t.Fatal("expected error for unexpected exit code")
+	}
+}
+
+func TestExpectSelector_ArgvConstruction(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     SelectOptions
+		wantArgs []string
+	}{
+		{
+			name:     "single select, default keys",
+			opts:     SelectOptions{},
+			wantArgs: []string{"--print-query", "--expect=ctrl-y,ctrl-o,enter"},
+		},
+		{
+			name:     "multi select",
+			opts:     SelectOptions{Multi: true},
+			wantArgs: []string{"--print-query", "--multi", "--expect=ctrl-y,ctrl-o,enter"},
+		},
+		{
+			name:     "custom expect keys",
+			opts:     SelectOptions{ExpectKeys: []string{"ctrl-c"}},
+			wantArgs: []string{"--print-query", "--expect=ctrl-c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, ok := Get("fzf")
+			if !ok {
+				t.Fatal("fzf not registered")
+			}
+			es := factory().(*expectSelector)
+			runner := &fakeCommandRunner{output: []byte("\nenter\nhttps://example.com\n")}
+			es.runner = runner
+
+			if _, err := es.Select(context.Background(), []string{"https://example.com"}, tt.opts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(runner.lastArgs) != len(tt.wantArgs) {
+				t.Fatalf("expected args %v, got %v", tt.wantArgs, runner.lastArgs)
+			}
+			for i := range tt.wantArgs {
+				if runner.lastArgs[i] != tt.wantArgs[i] {
+					t.Errorf("expected args %v, got %v", tt.wantArgs, runner.lastArgs)
+				}
+			}
+		})
+	}
+}
+
+func TestExpectSelector_MultiSelection(t *testing.T) {
+	factory, _ := Get("fzf")
+	es := factory().(*expectSelector)
+	es.runner = &fakeCommandRunner{
+		output: []byte("\nenter\nhttps://a.example\nhttps://b.example\n"),
+	}
+
+	got, err := es.Select(context.Background(), []string{"https://a.example", "https://b.example"}, SelectOptions{Multi: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.URLs) != 2 || got.URLs[0] != "https://a.example" || got.URLs[1] != "https://b.example" {
+		t.Errorf("expected both URLs, got %v", got.URLs)
+	}
+	if got.Action != "open" {
+		t.Errorf("expected action open, got %q", got.Action)
+	}
+}
+
+func TestExpectSelector_KeyMapsToAction(t *testing.T) {
+	factory, _ := Get("fzf")
+	es := factory().(*expectSelector)
+	es.runner = &fakeCommandRunner{
+		output: []byte("\nctrl-y\nhttps://example.com\n"),
+	}
+
+	got, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Action != "yank" {
+		t.Errorf("expected action yank, got %q", got.Action)
+	}
+}
+
+func TestExpectSelector_UnknownKeyFallsBackToOpen(t *testing.T) {
+	factory, _ := Get("fzf")
+	es := factory().(*expectSelector)
+	es.runner = &fakeCommandRunner{
+		output: []byte("\nctrl-z\nhttps://example.com\n"),
+	}
+
+	got, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Action != "open" {
+		t.Errorf("expected fallback action open, got %q", got.Action)
+	}
+}
+
+func TestExpectSelector_EmptySecondLineCancels(t *testing.T) {
+	factory, _ := Get("fzf")
+	es := factory().(*expectSelector)
+	es.runner = &fakeCommandRunner{output: []byte("\n\n")}
+
+	got, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.URLs) != 0 {
+		t.Errorf("expected cancellation, got %v", got.URLs)
+	}
+}
+
+func TestExpectSelector_ExitCodeCancellation(t *testing.T) {
+	factory, _ := Get("sk")
+	es := factory().(*expectSelector)
+	es.runner = &fakeCommandRunner{exitCode: 130, err: errors.New("exit status")}
+
+	got, err := es.Select(context.Background(), []string{"https://example.com"}, SelectOptions{})
+	if err != nil {
+		t.Fatalf("expected cancellation to not be an error, got %v", err)
+	}
+	if len(got.URLs) != 0 {
+		t.Errorf("expected cancellation, got %v", got.URLs)
+	}
+}
+
+func TestAuto_PrefersFirstAvailableOnPath(t *testing.T) {
+	available := map[string]bool{"rofi": true}
+	lookPath := func(file string) (string, error) {
+		if available[file] {
+			return "/usr/bin/" + file, nil
+		}
+		return "", errors.New("not found")
+	}
+
+	if got := Auto(lookPath); got != "rofi" {
+		t.Errorf("expected rofi, got %q", got)
+	}
+}
+
+func TestAuto_FallsBackToBuiltin(t *testing.T) {
+	lookPath := func(file string) (string, error) {
+		return "", errors.New("not found")
+	}
+
+	if got := Auto(lookPath); got != builtinName {
+		t.Errorf("expected %q, got %q", builtinName, got)
+	}
+}
+
+func TestBuiltinSelector_ReadsIndex(t *testing.T) {
+	var out strings.Builder
+	b := &builtinSelector{out: &out, in: strings.NewReader("2\n")}
+
+	got, err := b.Select(context.Background(), []string{"https://a.example", "https://b.example"}, SelectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.URLs) != 1 || got.URLs[0] != "https://b.example" {
+		t.Errorf("expected https://b.example, got %v", got.URLs)
+	}
+}
+
+func TestBuiltinSelector_EmptyCancels(t *testing.T) {
+	var out strings.Builder
+	b := &builtinSelector{out: &out, in: strings.NewReader("\n")}
+
+	got, err := b.Select(context.Background(), []string{"https://a.example"}, SelectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.URLs) != 0 {
+		t.Errorf("expected empty selection on cancel, got %v", got.URLs)
+	}
+}